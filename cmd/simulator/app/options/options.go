@@ -0,0 +1,171 @@
+// Package options defines the command-line flags for the simulator binary,
+// including a deprecated flag for every setting that used to live only in
+// config.yml.
+package options
+
+import (
+	"github.com/spf13/pflag"
+	"golang.org/x/xerrors"
+
+	simulatorconfig "sigs.k8s.io/kube-scheduler-simulator/simulator/config"
+	apiconfig "sigs.k8s.io/kube-scheduler-simulator/simulator/config/apis/config"
+	"sigs.k8s.io/kube-scheduler-simulator/simulator/config/apis/config/validation"
+)
+
+// Options holds everything needed to build the simulator's
+// apiconfig.SimulatorConfiguration from the command line: the path to the
+// config file, plus one deprecated flag per setting config.yml used to
+// carry, for backwards compatibility with existing deployments.
+type Options struct {
+	// ConfigFile is the path to the simulator's configuration file, passed
+	// via --config.
+	ConfigFile string
+
+	// The fields from Port to KubeSchedulerConfigPath are deprecated in
+	// favor of ConfigFile and are kept only so existing `--flag=value`
+	// invocations keep working; the fields after them back flags that are
+	// not deprecated. Each field below overrides the matching field of the
+	// loaded configuration only if it was explicitly set on the command line.
+	Port                     int32
+	EtcdURL                  string
+	CorsAllowedOriginList    []string
+	KubeAPIHost              string
+	KubeAPIPort              int32
+	ExternalImportEnabled    bool
+	ExternalSchedulerEnabled bool
+	KubeSchedulerConfigPath  string
+
+	ExternalKubeConfigPath            string
+	ExternalKubeContext               string
+	ExternalKubeMasterURL             string
+	ExternalKubeInsecureSkipTLSVerify bool
+	ExternalKubeImpersonateUser       string
+	ExternalKubeImpersonateGroups     []string
+
+	HealthzBindAddress string
+	MetricsBindAddress string
+	LeaderElect        bool
+
+	flags *pflag.FlagSet
+}
+
+// NewOptions returns a new, empty Options.
+func NewOptions() *Options {
+	return &Options{}
+}
+
+// AddFlags registers --config and every deprecated flag on fs.
+func (o *Options) AddFlags(fs *pflag.FlagSet) {
+	o.flags = fs
+
+	fs.StringVar(&o.ConfigFile, "config", o.ConfigFile, "The path to the simulator configuration file.")
+
+	fs.Int32Var(&o.Port, "port", 0, "The port that the simulator server listens on.")
+	_ = fs.MarkDeprecated("port", "use --config instead")
+	fs.StringVar(&o.EtcdURL, "etcd-url", "", "The URL of the etcd the simulator's API server talks to.")
+	_ = fs.MarkDeprecated("etcd-url", "use --config instead")
+	fs.StringSliceVar(&o.CorsAllowedOriginList, "cors-allowed-origin-list", nil, "The allowed origin list applied to the kube-apiserver and the simulator server.")
+	_ = fs.MarkDeprecated("cors-allowed-origin-list", "use --config instead")
+	fs.StringVar(&o.KubeAPIHost, "kube-api-host", "", "The host advertised as the kube-apiserver's URL.")
+	_ = fs.MarkDeprecated("kube-api-host", "use --config instead")
+	fs.Int32Var(&o.KubeAPIPort, "kube-api-port", 0, "The port advertised as the kube-apiserver's URL.")
+	_ = fs.MarkDeprecated("kube-api-port", "use --config instead")
+	fs.BoolVar(&o.ExternalImportEnabled, "external-import-enabled", false, "Whether the simulator imports resources from an existing cluster.")
+	_ = fs.MarkDeprecated("external-import-enabled", "use --config instead")
+	fs.BoolVar(&o.ExternalSchedulerEnabled, "external-scheduler-enabled", false, "Whether an external scheduler is enabled.")
+	_ = fs.MarkDeprecated("external-scheduler-enabled", "use --config instead")
+	fs.StringVar(&o.KubeSchedulerConfigPath, "kube-scheduler-config", "", "The path to the initial KubeSchedulerConfiguration.")
+	_ = fs.MarkDeprecated("kube-scheduler-config", "use --config instead")
+
+	fs.StringVar(&o.ExternalKubeConfigPath, "external-kubeconfig", "", "The path to the kubeconfig used to import resources from an external cluster.")
+	fs.StringVar(&o.ExternalKubeContext, "external-kube-context", "", "The kubeconfig context to use for the external cluster.")
+	fs.StringVar(&o.ExternalKubeMasterURL, "external-kube-master-url", "", "Overrides the API server URL read from the external kubeconfig.")
+	fs.BoolVar(&o.ExternalKubeInsecureSkipTLSVerify, "external-kube-insecure-skip-tls-verify", false, "Disables TLS certificate verification when talking to the external cluster.")
+	fs.StringVar(&o.ExternalKubeImpersonateUser, "external-kube-impersonate-user", "", "The username to impersonate when talking to the external cluster.")
+	fs.StringSliceVar(&o.ExternalKubeImpersonateGroups, "external-kube-impersonate-groups", nil, "The groups to impersonate when talking to the external cluster.")
+
+	fs.StringVar(&o.HealthzBindAddress, "healthz-bind-address", "", "The host:port the /healthz and /readyz endpoints are served on.")
+	fs.StringVar(&o.MetricsBindAddress, "metrics-bind-address", "", "The host:port Prometheus metrics are served on.")
+	fs.BoolVar(&o.LeaderElect, "leader-elect", false, "Whether to run the simulator under leader election, so only one replica is active at a time.")
+}
+
+// Config loads the configuration from o.ConfigFile (if set), then overlays
+// every deprecated flag that was explicitly passed on the command line,
+// re-validates the result, and returns the resulting, validated
+// apiconfig.SimulatorConfiguration.
+func (o *Options) Config() (*apiconfig.SimulatorConfiguration, error) {
+	cc, err := simulatorconfig.LoadConfig(o.ConfigFile)
+	if err != nil {
+		return nil, xerrors.Errorf("load config: %w", err)
+	}
+
+	o.applyFlagOverrides(cc)
+
+	if err := validation.ValidateSimulatorConfiguration(cc); err != nil {
+		return nil, xerrors.Errorf("validate configuration: %w", err)
+	}
+
+	return cc, nil
+}
+
+// applyFlagOverrides overrides fields of cc with any flag the user
+// explicitly set, so a bare `--port` still takes effect without a config
+// file, and external-cluster flags take effect without editing the
+// kubeconfig directly.
+func (o *Options) applyFlagOverrides(cc *apiconfig.SimulatorConfiguration) {
+	if o.flags == nil {
+		return
+	}
+
+	if o.flags.Changed("port") {
+		cc.Port = o.Port
+	}
+	if o.flags.Changed("etcd-url") {
+		cc.EtcdURL = o.EtcdURL
+	}
+	if o.flags.Changed("cors-allowed-origin-list") {
+		cc.CorsAllowedOriginList = o.CorsAllowedOriginList
+	}
+	if o.flags.Changed("kube-api-host") {
+		cc.KubeAPIHost = o.KubeAPIHost
+	}
+	if o.flags.Changed("kube-api-port") {
+		cc.KubeAPIPort = o.KubeAPIPort
+	}
+	if o.flags.Changed("external-import-enabled") {
+		cc.ExternalImportEnabled = o.ExternalImportEnabled
+	}
+	if o.flags.Changed("external-scheduler-enabled") {
+		cc.ExternalSchedulerEnabled = o.ExternalSchedulerEnabled
+	}
+	if o.flags.Changed("kube-scheduler-config") {
+		cc.KubeSchedulerConfigPath = o.KubeSchedulerConfigPath
+	}
+	if o.flags.Changed("external-kubeconfig") {
+		cc.ExternalKubeConfigPath = o.ExternalKubeConfigPath
+	}
+	if o.flags.Changed("external-kube-context") {
+		cc.ExternalKubeContext = o.ExternalKubeContext
+	}
+	if o.flags.Changed("external-kube-master-url") {
+		cc.ExternalKubeMasterURL = o.ExternalKubeMasterURL
+	}
+	if o.flags.Changed("external-kube-insecure-skip-tls-verify") {
+		cc.ExternalKubeInsecureSkipTLSVerify = o.ExternalKubeInsecureSkipTLSVerify
+	}
+	if o.flags.Changed("external-kube-impersonate-user") {
+		cc.ExternalKubeImpersonateUser = o.ExternalKubeImpersonateUser
+	}
+	if o.flags.Changed("external-kube-impersonate-groups") {
+		cc.ExternalKubeImpersonateGroups = o.ExternalKubeImpersonateGroups
+	}
+	if o.flags.Changed("healthz-bind-address") {
+		cc.HealthzBindAddress = o.HealthzBindAddress
+	}
+	if o.flags.Changed("metrics-bind-address") {
+		cc.MetricsBindAddress = o.MetricsBindAddress
+	}
+	if o.flags.Changed("leader-elect") {
+		cc.LeaderElection.LeaderElect = o.LeaderElect
+	}
+}
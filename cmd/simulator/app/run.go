@@ -0,0 +1,48 @@
+package app
+
+import (
+	"context"
+
+	"golang.org/x/sync/errgroup"
+	frameworkruntime "k8s.io/kubernetes/pkg/scheduler/framework/runtime"
+
+	"sigs.k8s.io/kube-scheduler-simulator/simulator/config"
+	"sigs.k8s.io/kube-scheduler-simulator/simulator/scheduler/plugin/schedulingpolicywebhook"
+	"sigs.k8s.io/kube-scheduler-simulator/simulator/server"
+	"sigs.k8s.io/kube-scheduler-simulator/simulator/server/healthz"
+)
+
+// Run starts the simulator with the given, already-validated configuration:
+// a healthz/metrics server on HealthzBindAddress and, when it differs, a
+// second one on MetricsBindAddress (each serving /healthz, /readyz and
+// /metrics), and the main simulator server under leader election. It blocks
+// until ctx is cancelled or one of them returns an error.
+func Run(ctx context.Context, cfg *config.Config) error {
+	g, ctx := errgroup.WithContext(ctx)
+
+	outOfTreeRegistry := frameworkruntime.Registry{
+		schedulingpolicywebhook.Name: schedulingpolicywebhook.NewFactory(cfg.SchedulingPolicyWebhook()),
+	}
+
+	g.Go(func() error {
+		return healthz.Serve(ctx, cfg.HealthzBindAddress)
+	})
+	if cfg.MetricsBindAddress != cfg.HealthzBindAddress {
+		g.Go(func() error {
+			return healthz.Serve(ctx, cfg.MetricsBindAddress)
+		})
+	}
+
+	g.Go(func() error {
+		var serveErr error
+		err := cfg.RunLeaderElection(ctx, func(ctx context.Context) {
+			serveErr = server.Start(ctx, cfg, outOfTreeRegistry)
+		})
+		if err != nil {
+			return err
+		}
+		return serveErr
+	})
+
+	return g.Wait()
+}
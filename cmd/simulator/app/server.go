@@ -0,0 +1,38 @@
+// Package app wires together the simulator's command-line options and
+// configuration loading into the root Cobra command.
+package app
+
+import (
+	"github.com/spf13/cobra"
+	"golang.org/x/xerrors"
+
+	"sigs.k8s.io/kube-scheduler-simulator/cmd/simulator/app/options"
+	simulatorconfig "sigs.k8s.io/kube-scheduler-simulator/simulator/config"
+)
+
+// NewSimulatorCommand creates the root `simulator` Cobra command.
+func NewSimulatorCommand() *cobra.Command {
+	opts := options.NewOptions()
+
+	cmd := &cobra.Command{
+		Use:   "simulator",
+		Short: "simulator runs the kube-scheduler-simulator server",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cc, err := opts.Config()
+			if err != nil {
+				return xerrors.Errorf("build configuration: %w", err)
+			}
+
+			cfg, err := simulatorconfig.NewConfig(cc)
+			if err != nil {
+				return xerrors.Errorf("build simulator config: %w", err)
+			}
+
+			return Run(cmd.Context(), cfg)
+		},
+	}
+
+	opts.AddFlags(cmd.Flags())
+
+	return cmd
+}
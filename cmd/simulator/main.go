@@ -0,0 +1,14 @@
+// Command simulator runs the kube-scheduler-simulator server.
+package main
+
+import (
+	"os"
+
+	"sigs.k8s.io/kube-scheduler-simulator/cmd/simulator/app"
+)
+
+func main() {
+	if err := app.NewSimulatorCommand().Execute(); err != nil {
+		os.Exit(1)
+	}
+}
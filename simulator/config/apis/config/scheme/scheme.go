@@ -0,0 +1,33 @@
+// Package scheme exposes the runtime.Scheme and codec factory that know how
+// to decode and convert between the versioned simulator configuration APIs
+// and their internal representation.
+package scheme
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+
+	"sigs.k8s.io/kube-scheduler-simulator/simulator/config/apis/config"
+	"sigs.k8s.io/kube-scheduler-simulator/simulator/config/apis/config/v1alpha1"
+)
+
+var (
+	// Scheme knows about the internal and v1alpha1 SimulatorConfiguration
+	// types, and how to convert between them.
+	Scheme = runtime.NewScheme()
+	// Codecs provides access to encoding and decoding for the scheme.
+	Codecs = serializer.NewCodecFactory(Scheme)
+)
+
+func init() {
+	AddToScheme(Scheme)
+}
+
+// AddToScheme registers the internal and all known versions of the
+// simulator configuration API with the given scheme.
+func AddToScheme(scheme *runtime.Scheme) {
+	utilruntime.Must(config.AddToScheme(scheme))
+	utilruntime.Must(v1alpha1.AddToScheme(scheme))
+	utilruntime.Must(scheme.SetVersionPriority(v1alpha1.SchemeGroupVersion))
+}
@@ -0,0 +1,112 @@
+// Package config holds the internal (unversioned) representation of the
+// simulator's configuration. Versioned, wire-compatible representations
+// live under apis/config/<version> and are converted into this type
+// before the rest of the simulator ever sees them.
+package config
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	componentbaseconfig "k8s.io/component-base/config"
+)
+
+const (
+	// FailurePolicyIgnore means a pod is scheduled as if the scheduling
+	// policy webhook had not been configured when it cannot be reached.
+	FailurePolicyIgnore = "Ignore"
+	// FailurePolicyFail means a pod is marked Unschedulable when the
+	// scheduling policy webhook cannot be reached.
+	FailurePolicyFail = "Fail"
+)
+
+// SimulatorConfiguration is the internal representation of the simulator's
+// configuration. It is never decoded directly from YAML; instead, a
+// versioned type (e.g. v1alpha1.SimulatorConfiguration) is decoded and
+// converted into this type via scheme.Scheme.Convert.
+type SimulatorConfiguration struct {
+	// Port is the port that the simulator server listens on.
+	Port int32
+	// EtcdURL is the URL of the etcd the simulator's API server talks to.
+	EtcdURL string
+	// CorsAllowedOriginList is applied to both the kube-apiserver and the
+	// simulator server.
+	CorsAllowedOriginList []string
+
+	// KubeAPIHost and KubeAPIPort together form the address the simulator
+	// advertises as the kube-apiserver's URL.
+	KubeAPIHost string
+	KubeAPIPort int32
+
+	// KubeSchedulerConfigPath is the path to the initial
+	// KubeSchedulerConfiguration. If empty, the default kube-scheduler
+	// configuration is used.
+	KubeSchedulerConfigPath string
+
+	// ExternalImportEnabled indicates whether the simulator will import
+	// resources from an existing cluster or not.
+	ExternalImportEnabled bool
+	// ExternalSchedulerEnabled indicates whether an external scheduler is
+	// enabled.
+	ExternalSchedulerEnabled bool
+
+	// ExternalKubeConfigPath is the path to the kubeconfig used to import
+	// resources from an external cluster. If empty, the default client
+	// config loading rules (KUBECONFIG, ~/.kube/config, in-cluster config)
+	// are used.
+	ExternalKubeConfigPath string
+	// ExternalKubeContext selects a non-default context from the
+	// kubeconfig at ExternalKubeConfigPath.
+	ExternalKubeContext string
+	// ExternalKubeMasterURL overrides the API server URL read from the
+	// kubeconfig.
+	ExternalKubeMasterURL string
+	// ExternalKubeInsecureSkipTLSVerify disables TLS certificate
+	// verification when talking to the external cluster.
+	ExternalKubeInsecureSkipTLSVerify bool
+	// ExternalKubeImpersonateUser, if set, is the username the simulator
+	// impersonates when talking to the external cluster.
+	ExternalKubeImpersonateUser string
+	// ExternalKubeImpersonateGroups, if set, are the groups the simulator
+	// impersonates when talking to the external cluster. They are applied
+	// only when ExternalKubeImpersonateUser is also set.
+	ExternalKubeImpersonateGroups []string
+
+	// ClientConnection specifies the kubeconfig file and client connection
+	// settings for the proxy server to use when communicating with the
+	// apiserver.
+	ClientConnection componentbaseconfig.ClientConnectionConfiguration
+	// Debugging holds configuration for debugging related features.
+	Debugging componentbaseconfig.DebuggingConfiguration
+	// LeaderElection defines the configuration of leader election, so the
+	// simulator can run as an HA workload.
+	LeaderElection componentbaseconfig.LeaderElectionConfiguration
+
+	// HealthzBindAddress is the host:port the /healthz and /readyz
+	// endpoints are served on, independent of Port.
+	HealthzBindAddress string
+	// MetricsBindAddress is the host:port Prometheus metrics are served
+	// on, independent of Port.
+	MetricsBindAddress string
+
+	// SchedulingPolicyWebhook, if set, configures an external webhook the
+	// simulator consults for scheduling decisions, mirroring the federation
+	// SchedulingPolicy admission pattern. If nil, no webhook is called.
+	SchedulingPolicyWebhook *SchedulingPolicyWebhookConfiguration
+}
+
+// SchedulingPolicyWebhookConfiguration configures the optional external
+// scheduling-policy webhook. When present on SimulatorConfiguration, the
+// scheduler wiring registers a Filter/Score plugin that POSTs each pod's
+// scheduling context to the webhook and applies the returned node
+// constraints or scoring weights before the in-tree plugins run.
+type SchedulingPolicyWebhookConfiguration struct {
+	// KubeconfigPath is the path to the kubeconfig used to reach the
+	// webhook server, loaded the same way kube-apiserver loads its
+	// admission and authorization webhook kubeconfigs.
+	KubeconfigPath string
+	// RetryBackoff is how long to wait between retries of a failed
+	// webhook call.
+	RetryBackoff metav1.Duration
+	// FailurePolicy is FailurePolicyIgnore or FailurePolicyFail; it
+	// determines what happens to a pod when the webhook cannot be reached.
+	FailurePolicy string
+}
@@ -0,0 +1,123 @@
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/conversion"
+	"k8s.io/apimachinery/pkg/runtime"
+	componentbaseconfig "k8s.io/component-base/config"
+	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
+
+	"sigs.k8s.io/kube-scheduler-simulator/simulator/config/apis/config"
+)
+
+func addConversionFuncs(scheme *runtime.Scheme) error {
+	if err := scheme.AddConversionFunc((*SimulatorConfiguration)(nil), (*config.SimulatorConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SimulatorConfiguration_To_config_SimulatorConfiguration(a.(*SimulatorConfiguration), b.(*config.SimulatorConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	return scheme.AddConversionFunc((*config.SimulatorConfiguration)(nil), (*SimulatorConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_SimulatorConfiguration_To_v1alpha1_SimulatorConfiguration(a.(*config.SimulatorConfiguration), b.(*SimulatorConfiguration), scope)
+	})
+}
+
+// Convert_v1alpha1_SimulatorConfiguration_To_config_SimulatorConfiguration converts the
+// versioned, wire-format configuration into the internal representation the
+// rest of the simulator uses.
+func Convert_v1alpha1_SimulatorConfiguration_To_config_SimulatorConfiguration(in *SimulatorConfiguration, out *config.SimulatorConfiguration, s conversion.Scope) error {
+	out.Port = in.Port
+	out.EtcdURL = in.EtcdURL
+	out.CorsAllowedOriginList = in.CorsAllowedOriginList
+	out.KubeAPIHost = in.KubeAPIHost
+	out.KubeAPIPort = in.KubeAPIPort
+	out.KubeSchedulerConfigPath = in.KubeSchedulerConfigPath
+	out.ExternalImportEnabled = in.ExternalImportEnabled
+	out.ExternalSchedulerEnabled = in.ExternalSchedulerEnabled
+	out.ExternalKubeConfigPath = in.ExternalKubeConfigPath
+	out.ExternalKubeContext = in.ExternalKubeContext
+	out.ExternalKubeMasterURL = in.ExternalKubeMasterURL
+	out.ExternalKubeInsecureSkipTLSVerify = in.ExternalKubeInsecureSkipTLSVerify
+	out.ExternalKubeImpersonateUser = in.ExternalKubeImpersonateUser
+	out.ExternalKubeImpersonateGroups = in.ExternalKubeImpersonateGroups
+	if err := componentbaseconfigv1alpha1.Convert_v1alpha1_ClientConnectionConfiguration_To_config_ClientConnectionConfiguration(&in.ClientConnection, &out.ClientConnection, s); err != nil {
+		return err
+	}
+	if err := componentbaseconfigv1alpha1.Convert_v1alpha1_DebuggingConfiguration_To_config_DebuggingConfiguration(&in.Debugging, &out.Debugging, s); err != nil {
+		return err
+	}
+	Convert_v1alpha1_LeaderElectionConfiguration_To_config_LeaderElectionConfiguration(&in.LeaderElection, &out.LeaderElection)
+	out.HealthzBindAddress = in.HealthzBindAddress
+	out.MetricsBindAddress = in.MetricsBindAddress
+	if in.SchedulingPolicyWebhook != nil {
+		out.SchedulingPolicyWebhook = &config.SchedulingPolicyWebhookConfiguration{
+			KubeconfigPath: in.SchedulingPolicyWebhook.KubeconfigPath,
+			RetryBackoff:   in.SchedulingPolicyWebhook.RetryBackoff,
+			FailurePolicy:  in.SchedulingPolicyWebhook.FailurePolicy,
+		}
+	}
+	return nil
+}
+
+// Convert_v1alpha1_LeaderElectionConfiguration_To_config_LeaderElectionConfiguration converts
+// the v1alpha1 LeaderElectionConfiguration, whose LeaderElect is an optional
+// *bool, to the internal representation, whose LeaderElect is a plain bool.
+func Convert_v1alpha1_LeaderElectionConfiguration_To_config_LeaderElectionConfiguration(in *componentbaseconfigv1alpha1.LeaderElectionConfiguration, out *componentbaseconfig.LeaderElectionConfiguration) {
+	if in.LeaderElect != nil {
+		out.LeaderElect = *in.LeaderElect
+	}
+	out.LeaseDuration = in.LeaseDuration
+	out.RenewDeadline = in.RenewDeadline
+	out.RetryPeriod = in.RetryPeriod
+	out.ResourceLock = in.ResourceLock
+	out.ResourceName = in.ResourceName
+	out.ResourceNamespace = in.ResourceNamespace
+}
+
+// Convert_config_LeaderElectionConfiguration_To_v1alpha1_LeaderElectionConfiguration converts
+// the internal LeaderElectionConfiguration back to v1alpha1.
+func Convert_config_LeaderElectionConfiguration_To_v1alpha1_LeaderElectionConfiguration(in *componentbaseconfig.LeaderElectionConfiguration, out *componentbaseconfigv1alpha1.LeaderElectionConfiguration) {
+	leaderElect := in.LeaderElect
+	out.LeaderElect = &leaderElect
+	out.LeaseDuration = in.LeaseDuration
+	out.RenewDeadline = in.RenewDeadline
+	out.RetryPeriod = in.RetryPeriod
+	out.ResourceLock = in.ResourceLock
+	out.ResourceName = in.ResourceName
+	out.ResourceNamespace = in.ResourceNamespace
+}
+
+// Convert_config_SimulatorConfiguration_To_v1alpha1_SimulatorConfiguration converts the
+// internal configuration back into the v1alpha1 wire format, e.g. so it can
+// be round-tripped in tests or re-serialized for diagnostics.
+func Convert_config_SimulatorConfiguration_To_v1alpha1_SimulatorConfiguration(in *config.SimulatorConfiguration, out *SimulatorConfiguration, s conversion.Scope) error {
+	out.Port = in.Port
+	out.EtcdURL = in.EtcdURL
+	out.CorsAllowedOriginList = in.CorsAllowedOriginList
+	out.KubeAPIHost = in.KubeAPIHost
+	out.KubeAPIPort = in.KubeAPIPort
+	out.KubeSchedulerConfigPath = in.KubeSchedulerConfigPath
+	out.ExternalImportEnabled = in.ExternalImportEnabled
+	out.ExternalSchedulerEnabled = in.ExternalSchedulerEnabled
+	out.ExternalKubeConfigPath = in.ExternalKubeConfigPath
+	out.ExternalKubeContext = in.ExternalKubeContext
+	out.ExternalKubeMasterURL = in.ExternalKubeMasterURL
+	out.ExternalKubeInsecureSkipTLSVerify = in.ExternalKubeInsecureSkipTLSVerify
+	out.ExternalKubeImpersonateUser = in.ExternalKubeImpersonateUser
+	out.ExternalKubeImpersonateGroups = in.ExternalKubeImpersonateGroups
+	if err := componentbaseconfigv1alpha1.Convert_config_ClientConnectionConfiguration_To_v1alpha1_ClientConnectionConfiguration(&in.ClientConnection, &out.ClientConnection, s); err != nil {
+		return err
+	}
+	if err := componentbaseconfigv1alpha1.Convert_config_DebuggingConfiguration_To_v1alpha1_DebuggingConfiguration(&in.Debugging, &out.Debugging, s); err != nil {
+		return err
+	}
+	Convert_config_LeaderElectionConfiguration_To_v1alpha1_LeaderElectionConfiguration(&in.LeaderElection, &out.LeaderElection)
+	out.HealthzBindAddress = in.HealthzBindAddress
+	out.MetricsBindAddress = in.MetricsBindAddress
+	if in.SchedulingPolicyWebhook != nil {
+		out.SchedulingPolicyWebhook = &SchedulingPolicyWebhookConfiguration{
+			KubeconfigPath: in.SchedulingPolicyWebhook.KubeconfigPath,
+			RetryBackoff:   in.SchedulingPolicyWebhook.RetryBackoff,
+			FailurePolicy:  in.SchedulingPolicyWebhook.FailurePolicy,
+		}
+	}
+	return nil
+}
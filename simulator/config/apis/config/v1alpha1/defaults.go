@@ -0,0 +1,118 @@
+package v1alpha1
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
+
+	"sigs.k8s.io/kube-scheduler-simulator/simulator/config/apis/config"
+)
+
+const (
+	// DefaultPort is the port the simulator server listens on when Port is
+	// left unset.
+	DefaultPort = 1212
+	// DefaultKubeAPIHost is the host advertised as the kube-apiserver's URL
+	// when KubeAPIHost is left unset.
+	DefaultKubeAPIHost = "127.0.0.1"
+	// DefaultKubeAPIPort is the port advertised as the kube-apiserver's URL
+	// when KubeAPIPort is left unset.
+	DefaultKubeAPIPort = 3131
+	// DefaultEtcdURL is used when EtcdURL is left unset.
+	DefaultEtcdURL = "http://127.0.0.1:2379"
+
+	// DefaultHealthzBindAddress is used when HealthzBindAddress is left
+	// unset.
+	DefaultHealthzBindAddress = "0.0.0.0:10350"
+	// DefaultMetricsBindAddress is used when MetricsBindAddress is left
+	// unset.
+	DefaultMetricsBindAddress = "0.0.0.0:10351"
+
+	// DefaultLeaderElectionResourceLock is used when
+	// LeaderElection.ResourceLock is left unset.
+	DefaultLeaderElectionResourceLock = "leases"
+	// DefaultLeaderElectionResourceNamespace is used when
+	// LeaderElection.ResourceNamespace is left unset.
+	DefaultLeaderElectionResourceNamespace = "kube-system"
+	// DefaultLeaderElectionResourceName is used when
+	// LeaderElection.ResourceName is left unset.
+	DefaultLeaderElectionResourceName = "kube-scheduler-simulator"
+)
+
+var (
+	// DefaultSchedulingPolicyWebhookRetryBackoff is used when
+	// SchedulingPolicyWebhook.RetryBackoff is left unset but the webhook is
+	// enabled.
+	DefaultSchedulingPolicyWebhookRetryBackoff = metav1.Duration{Duration: 500 * time.Millisecond}
+)
+
+func addDefaultingFuncs(scheme *runtime.Scheme) error {
+	return RegisterDefaults(scheme)
+}
+
+// RegisterDefaults registers the defaulting functions in this file with the
+// given scheme.
+func RegisterDefaults(scheme *runtime.Scheme) error {
+	scheme.AddTypeDefaultingFunc(&SimulatorConfiguration{}, func(obj interface{}) {
+		SetObjectDefaults_SimulatorConfiguration(obj.(*SimulatorConfiguration))
+	})
+	return nil
+}
+
+// SetObjectDefaults_SimulatorConfiguration defaults obj and the sub-structs
+// it embeds.
+func SetObjectDefaults_SimulatorConfiguration(obj *SimulatorConfiguration) {
+	SetDefaults_SimulatorConfiguration(obj)
+	componentbaseconfigv1alpha1.RecommendedDefaultClientConnectionConfiguration(&obj.ClientConnection)
+	componentbaseconfigv1alpha1.RecommendedDefaultDebuggingConfiguration(&obj.Debugging)
+	componentbaseconfigv1alpha1.RecommendedDefaultLeaderElectionConfiguration(&obj.LeaderElection)
+}
+
+// SetDefaults_SimulatorConfiguration fills in any field left zero-valued in
+// obj with the simulator's defaults.
+func SetDefaults_SimulatorConfiguration(obj *SimulatorConfiguration) {
+	if obj.Port == 0 {
+		obj.Port = DefaultPort
+	}
+	if obj.EtcdURL == "" {
+		obj.EtcdURL = DefaultEtcdURL
+	}
+	if obj.KubeAPIHost == "" {
+		obj.KubeAPIHost = DefaultKubeAPIHost
+	}
+	if obj.KubeAPIPort == 0 {
+		obj.KubeAPIPort = DefaultKubeAPIPort
+	}
+	if obj.HealthzBindAddress == "" {
+		obj.HealthzBindAddress = DefaultHealthzBindAddress
+	}
+	if obj.MetricsBindAddress == "" {
+		obj.MetricsBindAddress = DefaultMetricsBindAddress
+	}
+	if obj.LeaderElection.ResourceLock == "" {
+		obj.LeaderElection.ResourceLock = DefaultLeaderElectionResourceLock
+	}
+	if obj.LeaderElection.ResourceNamespace == "" {
+		obj.LeaderElection.ResourceNamespace = DefaultLeaderElectionResourceNamespace
+	}
+	if obj.LeaderElection.ResourceName == "" {
+		obj.LeaderElection.ResourceName = DefaultLeaderElectionResourceName
+	}
+	if obj.LeaderElection.LeaderElect == nil {
+		// Unlike kube-scheduler, the simulator is meant to run standalone
+		// most of the time, so it defaults to leader election disabled
+		// instead of RecommendedDefaultLeaderElectionConfiguration's true.
+		leaderElect := false
+		obj.LeaderElection.LeaderElect = &leaderElect
+	}
+	if obj.SchedulingPolicyWebhook != nil {
+		if obj.SchedulingPolicyWebhook.FailurePolicy == "" {
+			obj.SchedulingPolicyWebhook.FailurePolicy = config.FailurePolicyIgnore
+		}
+		if obj.SchedulingPolicyWebhook.RetryBackoff.Duration == 0 {
+			obj.SchedulingPolicyWebhook.RetryBackoff = DefaultSchedulingPolicyWebhookRetryBackoff
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"sigs.k8s.io/kube-scheduler-simulator/simulator/config/apis/config"
+)
+
+// SchemeGroupVersion is the group version used to register the v1alpha1
+// types.
+var SchemeGroupVersion = schema.GroupVersion{Group: config.GroupName, Version: "v1alpha1"}
+
+var (
+	// SchemeBuilder collects functions that add things to a scheme.
+	SchemeBuilder = runtime.NewSchemeBuilder(addKnownTypes)
+	// localSchemeBuilder is used so defaulting and conversion funcs can
+	// register themselves from init() without an import cycle.
+	localSchemeBuilder = &SchemeBuilder
+	// AddToScheme applies all the stored functions to the scheme.
+	AddToScheme = SchemeBuilder.AddToScheme
+)
+
+func init() {
+	localSchemeBuilder.Register(addDefaultingFuncs, addConversionFuncs)
+}
+
+func addKnownTypes(scheme *runtime.Scheme) error {
+	scheme.AddKnownTypes(SchemeGroupVersion, &SimulatorConfiguration{})
+	return nil
+}
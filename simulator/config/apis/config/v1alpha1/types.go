@@ -0,0 +1,120 @@
+// Package v1alpha1 is the v1alpha1 version of the simulator's configuration
+// API. It is the wire format read from the `--config` YAML file; it is
+// converted into the internal apis/config.SimulatorConfiguration before use.
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
+)
+
+// SimulatorConfiguration is the v1alpha1 representation of the simulator's
+// configuration file.
+type SimulatorConfiguration struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Port is the port that the simulator server listens on.
+	// +optional
+	Port int32 `json:"port,omitempty"`
+	// EtcdURL is the URL of the etcd the simulator's API server talks to.
+	// +optional
+	EtcdURL string `json:"etcdURL,omitempty"`
+	// CorsAllowedOriginList is applied to both the kube-apiserver and the
+	// simulator server.
+	// +optional
+	CorsAllowedOriginList []string `json:"corsAllowedOriginList,omitempty"`
+
+	// KubeAPIHost and KubeAPIPort together form the address the simulator
+	// advertises as the kube-apiserver's URL.
+	// +optional
+	KubeAPIHost string `json:"kubeAPIHost,omitempty"`
+	// +optional
+	KubeAPIPort int32 `json:"kubeAPIPort,omitempty"`
+
+	// KubeSchedulerConfigPath is the path to the initial
+	// KubeSchedulerConfiguration. If empty, the default kube-scheduler
+	// configuration is used.
+	// +optional
+	KubeSchedulerConfigPath string `json:"kubeSchedulerConfigPath,omitempty"`
+
+	// ExternalImportEnabled indicates whether the simulator will import
+	// resources from an existing cluster or not.
+	// +optional
+	ExternalImportEnabled bool `json:"externalImportEnabled,omitempty"`
+	// ExternalSchedulerEnabled indicates whether an external scheduler is
+	// enabled.
+	// +optional
+	ExternalSchedulerEnabled bool `json:"externalSchedulerEnabled,omitempty"`
+
+	// ExternalKubeConfigPath is the path to the kubeconfig used to import
+	// resources from an external cluster. If empty, the default client
+	// config loading rules (KUBECONFIG, ~/.kube/config, in-cluster config)
+	// are used.
+	// +optional
+	ExternalKubeConfigPath string `json:"externalKubeConfigPath,omitempty"`
+	// ExternalKubeContext selects a non-default context from the
+	// kubeconfig at ExternalKubeConfigPath.
+	// +optional
+	ExternalKubeContext string `json:"externalKubeContext,omitempty"`
+	// ExternalKubeMasterURL overrides the API server URL read from the
+	// kubeconfig.
+	// +optional
+	ExternalKubeMasterURL string `json:"externalKubeMasterURL,omitempty"`
+	// ExternalKubeInsecureSkipTLSVerify disables TLS certificate
+	// verification when talking to the external cluster.
+	// +optional
+	ExternalKubeInsecureSkipTLSVerify bool `json:"externalKubeInsecureSkipTLSVerify,omitempty"`
+	// ExternalKubeImpersonateUser, if set, is the username the simulator
+	// impersonates when talking to the external cluster.
+	// +optional
+	ExternalKubeImpersonateUser string `json:"externalKubeImpersonateUser,omitempty"`
+	// ExternalKubeImpersonateGroups, if set, are the groups the simulator
+	// impersonates when talking to the external cluster. They are applied
+	// only when ExternalKubeImpersonateUser is also set.
+	// +optional
+	ExternalKubeImpersonateGroups []string `json:"externalKubeImpersonateGroups,omitempty"`
+
+	// ClientConnection specifies the kubeconfig file and client connection
+	// settings for the proxy server to use when communicating with the
+	// apiserver.
+	// +optional
+	ClientConnection componentbaseconfigv1alpha1.ClientConnectionConfiguration `json:"clientConnection"`
+	// Debugging holds configuration for debugging related features.
+	// +optional
+	Debugging componentbaseconfigv1alpha1.DebuggingConfiguration `json:"debugging"`
+	// LeaderElection defines the configuration of leader election, so the
+	// simulator can run as an HA workload.
+	// +optional
+	LeaderElection componentbaseconfigv1alpha1.LeaderElectionConfiguration `json:"leaderElection"`
+
+	// HealthzBindAddress is the host:port the /healthz and /readyz
+	// endpoints are served on, independent of Port.
+	// +optional
+	HealthzBindAddress string `json:"healthzBindAddress,omitempty"`
+	// MetricsBindAddress is the host:port Prometheus metrics are served
+	// on, independent of Port.
+	// +optional
+	MetricsBindAddress string `json:"metricsBindAddress,omitempty"`
+
+	// SchedulingPolicyWebhook, if set, configures an external webhook the
+	// simulator consults for scheduling decisions. If unset, no webhook is
+	// called.
+	// +optional
+	SchedulingPolicyWebhook *SchedulingPolicyWebhookConfiguration `json:"schedulingPolicyWebhook,omitempty"`
+}
+
+// SchedulingPolicyWebhookConfiguration is the v1alpha1 representation of the
+// optional external scheduling-policy webhook.
+type SchedulingPolicyWebhookConfiguration struct {
+	// KubeconfigPath is the path to the kubeconfig used to reach the
+	// webhook server.
+	KubeconfigPath string `json:"kubeconfigPath"`
+	// RetryBackoff is how long to wait between retries of a failed
+	// webhook call.
+	// +optional
+	RetryBackoff metav1.Duration `json:"retryBackoff,omitempty"`
+	// FailurePolicy is "Ignore" or "Fail"; it determines what happens to a
+	// pod when the webhook cannot be reached.
+	// +optional
+	FailurePolicy string `json:"failurePolicy,omitempty"`
+}
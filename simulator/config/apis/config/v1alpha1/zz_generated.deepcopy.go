@@ -0,0 +1,53 @@
+// Code generated by hand to satisfy runtime.Object; keep in sync with types.go.
+
+package v1alpha1
+
+import (
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto copies all properties of this object into another object of
+// the same type that is provided as a pointer.
+func (in *SimulatorConfiguration) DeepCopyInto(out *SimulatorConfiguration) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.CorsAllowedOriginList != nil {
+		l := make([]string, len(in.CorsAllowedOriginList))
+		copy(l, in.CorsAllowedOriginList)
+		out.CorsAllowedOriginList = l
+	}
+	if in.ExternalKubeImpersonateGroups != nil {
+		l := make([]string, len(in.ExternalKubeImpersonateGroups))
+		copy(l, in.ExternalKubeImpersonateGroups)
+		out.ExternalKubeImpersonateGroups = l
+	}
+	out.ClientConnection = in.ClientConnection
+	out.Debugging = in.Debugging
+	out.LeaderElection = in.LeaderElection
+	if in.LeaderElection.LeaderElect != nil {
+		leaderElect := *in.LeaderElection.LeaderElect
+		out.LeaderElection.LeaderElect = &leaderElect
+	}
+	if in.SchedulingPolicyWebhook != nil {
+		out.SchedulingPolicyWebhook = new(SchedulingPolicyWebhookConfiguration)
+		*out.SchedulingPolicyWebhook = *in.SchedulingPolicyWebhook
+	}
+}
+
+// DeepCopy creates a deep copy of SimulatorConfiguration.
+func (in *SimulatorConfiguration) DeepCopy() *SimulatorConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(SimulatorConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject implements runtime.Object.
+func (in *SimulatorConfiguration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
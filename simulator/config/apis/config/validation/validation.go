@@ -0,0 +1,89 @@
+// Package validation validates the internal simulator configuration.
+package validation
+
+import (
+	"net/url"
+
+	"golang.org/x/xerrors"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"sigs.k8s.io/kube-scheduler-simulator/simulator/config/apis/config"
+)
+
+// ValidateSimulatorConfiguration checks an internal SimulatorConfiguration
+// and returns an aggregate error describing every field that is invalid.
+func ValidateSimulatorConfiguration(cc *config.SimulatorConfiguration) error {
+	var errs []error
+
+	if cc.Port <= 0 {
+		errs = append(errs, field.Invalid(field.NewPath("port"), cc.Port, "must be a positive integer"))
+	}
+	if cc.KubeAPIPort <= 0 {
+		errs = append(errs, field.Invalid(field.NewPath("kubeAPIPort"), cc.KubeAPIPort, "must be a positive integer"))
+	}
+	if cc.EtcdURL != "" {
+		if _, err := url.ParseRequestURI(cc.EtcdURL); err != nil {
+			errs = append(errs, field.Invalid(field.NewPath("etcdURL"), cc.EtcdURL, err.Error()))
+		}
+	}
+	for i, origin := range cc.CorsAllowedOriginList {
+		if _, err := url.ParseRequestURI(origin); err != nil {
+			errs = append(errs, field.Invalid(field.NewPath("corsAllowedOriginList").Index(i), origin, err.Error()))
+		}
+	}
+
+	errs = append(errs, validateLeaderElectionConfiguration(cc)...)
+	errs = append(errs, validateSchedulingPolicyWebhook(cc)...)
+
+	if err := utilerrors.NewAggregate(errs); err != nil {
+		return xerrors.Errorf("validate simulator configuration: %w", err)
+	}
+	return nil
+}
+
+// validateLeaderElectionConfiguration checks that, when leader election is
+// enabled, its durations make sense: the lease must outlive the deadline by
+// which a leader must renew it, which must in turn be longer than the
+// interval between retries.
+func validateLeaderElectionConfiguration(cc *config.SimulatorConfiguration) []error {
+	if !cc.LeaderElection.LeaderElect {
+		return nil
+	}
+
+	var errs []error
+	le := cc.LeaderElection
+	path := field.NewPath("leaderElection")
+
+	if le.LeaseDuration.Duration <= le.RenewDeadline.Duration {
+		errs = append(errs, field.Invalid(path.Child("leaseDuration"), le.LeaseDuration, "must be greater than renewDeadline"))
+	}
+	if le.RenewDeadline.Duration <= le.RetryPeriod.Duration {
+		errs = append(errs, field.Invalid(path.Child("renewDeadline"), le.RenewDeadline, "must be greater than retryPeriod"))
+	}
+	return errs
+}
+
+// validateSchedulingPolicyWebhook checks that, when the optional scheduling
+// policy webhook is configured, it names a kubeconfig to reach it and a
+// recognized failure policy.
+func validateSchedulingPolicyWebhook(cc *config.SimulatorConfiguration) []error {
+	wh := cc.SchedulingPolicyWebhook
+	if wh == nil {
+		return nil
+	}
+
+	var errs []error
+	path := field.NewPath("schedulingPolicyWebhook")
+
+	if wh.KubeconfigPath == "" {
+		errs = append(errs, field.Required(path.Child("kubeconfigPath"), "must be set when schedulingPolicyWebhook is configured"))
+	}
+	if wh.FailurePolicy != config.FailurePolicyIgnore && wh.FailurePolicy != config.FailurePolicyFail {
+		errs = append(errs, field.NotSupported(path.Child("failurePolicy"), wh.FailurePolicy, []string{config.FailurePolicyIgnore, config.FailurePolicyFail}))
+	}
+	if wh.RetryBackoff.Duration < 0 {
+		errs = append(errs, field.Invalid(path.Child("retryBackoff"), wh.RetryBackoff, "must not be negative"))
+	}
+	return errs
+}
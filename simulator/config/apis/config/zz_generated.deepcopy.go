@@ -0,0 +1,54 @@
+// Code generated by hand to satisfy runtime.Object; keep in sync with types.go.
+
+package config
+
+import (
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// DeepCopyInto copies all properties of this object into another object of
+// the same type that is provided as a pointer.
+func (in *SimulatorConfiguration) DeepCopyInto(out *SimulatorConfiguration) {
+	*out = *in
+	if in.CorsAllowedOriginList != nil {
+		l := make([]string, len(in.CorsAllowedOriginList))
+		copy(l, in.CorsAllowedOriginList)
+		out.CorsAllowedOriginList = l
+	}
+	if in.ExternalKubeImpersonateGroups != nil {
+		l := make([]string, len(in.ExternalKubeImpersonateGroups))
+		copy(l, in.ExternalKubeImpersonateGroups)
+		out.ExternalKubeImpersonateGroups = l
+	}
+	out.ClientConnection = in.ClientConnection
+	out.Debugging = in.Debugging
+	if in.SchedulingPolicyWebhook != nil {
+		out.SchedulingPolicyWebhook = new(SchedulingPolicyWebhookConfiguration)
+		*out.SchedulingPolicyWebhook = *in.SchedulingPolicyWebhook
+	}
+}
+
+// DeepCopy creates a deep copy of SimulatorConfiguration.
+func (in *SimulatorConfiguration) DeepCopy() *SimulatorConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(SimulatorConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject implements runtime.Object.
+func (in *SimulatorConfiguration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// GetObjectKind implements runtime.Object. The internal type carries no
+// TypeMeta, so it returns the empty kind.
+func (in *SimulatorConfiguration) GetObjectKind() schema.ObjectKind {
+	return schema.EmptyObjectKind
+}
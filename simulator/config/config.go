@@ -1,28 +1,22 @@
 package config
 
 import (
+	"os"
+	"strconv"
+
 	"golang.org/x/xerrors"
-	yaml "gopkg.in/yaml.v2"
-	"io/ioutil"
 	"k8s.io/client-go/rest"
-	"k8s.io/client-go/tools/clientcmd"
+	componentbaseconfig "k8s.io/component-base/config"
+	"k8s.io/klog/v2"
 	v1beta2config "k8s.io/kube-scheduler/config/v1beta2"
-	"k8s.io/kubernetes/pkg/scheduler/apis/config/scheme"
-	"net/url"
-	"os"
-	"strconv"
 
+	apiconfig "sigs.k8s.io/kube-scheduler-simulator/simulator/config/apis/config"
 	"sigs.k8s.io/kube-scheduler-simulator/simulator/scheduler/config"
 )
 
-// configYaml represents the value from the config file.
-var configYaml *ConfigYaml = &ConfigYaml{}
-
-// YamlFile is the config file path.
-// TODO: Config this file path by cli in main function.
-const YamlFile = "./config.yml"
-
-// Config is configuration for simulator.
+// Config is configuration for simulator. It is built from an
+// apiconfig.SimulatorConfiguration by NewConfig; nothing in this package
+// reads flags, environment variables or files directly.
 type Config struct {
 	Port                  int
 	KubeAPIServerURL      string
@@ -33,206 +27,130 @@ type Config struct {
 	// ExternalKubeClientCfg is KubeConfig to get resources from external cluster.
 	// This field is non-empty only when ExternalImportEnabled == true.
 	ExternalKubeClientCfg *rest.Config
-	InitialSchedulerCfg   *v1beta2config.KubeSchedulerConfiguration
 	// ExternalSchedulerEnabled indicates whether an external scheduler is enabled.
 	ExternalSchedulerEnabled bool
-}
 
-// ConfigYaml is the Go representation of a module configuration in the yaml
-// config file.
-type ConfigYaml struct {
-	Port                     int      `yaml:"Port"`
-	EtcdURL                  string   `yaml:"EtcdURL"`
-	CorsAllowedOriginList    []string `yaml:"CorsAllowedOriginList"`
-	KubeConfig               string   `yaml:"KubeConfig"`
-	KubeApiHost              string   `yaml:"KubeApiHost"`
-	KubeApiPort              int      `yaml:"KubeApiPort"`
-	KubeSchedulerConfigPath  string   `yaml:"KubeSchedulerConfigPath"`
-	ExternalImportEnabled    bool     `yaml:"ExternalImportEnabled"`
-	ExternalSchedulerEnabled bool     `yaml:"ExternalSchedulerEnabled"`
+	// LeaderElection defines the configuration of leader election, so the
+	// simulator can run as an HA workload. Use RunLeaderElection to run
+	// under it.
+	LeaderElection componentbaseconfig.LeaderElectionConfiguration
+	// HealthzBindAddress is the host:port the /healthz and /readyz
+	// endpoints are served on, independent of Port.
+	HealthzBindAddress string
+	// MetricsBindAddress is the host:port Prometheus metrics are served
+	// on, independent of Port.
+	MetricsBindAddress string
+
+	// initialSchedulerCfg is always expressed in the v1beta2 API, the
+	// version the rest of the simulator expects, regardless of which
+	// version was actually loaded from disk. Use InitialSchedulerCfg to
+	// read it.
+	initialSchedulerCfg *v1beta2config.KubeSchedulerConfiguration
+	// initialSchedulerCfgAPIVersion is the apiVersion the
+	// KubeSchedulerConfiguration was written in on disk, before any
+	// down-conversion to v1beta2. Use InitialSchedulerCfgAPIVersion to read
+	// it.
+	initialSchedulerCfgAPIVersion string
+
+	// schedulingPolicyWebhook is non-nil only when a scheduling policy
+	// webhook was configured. Use SchedulingPolicyWebhook to read it.
+	schedulingPolicyWebhook *WebhookConfig
 }
 
-// NewConfig gets some settings from environment variables.
-func NewConfig() (*Config, error) {
-	readConfigYaml()
-
-	port, err := getPort()
-	if err != nil {
-		return nil, xerrors.Errorf("get port: %w", err)
-	}
-
-	etcdurl, err := getEtcdURL()
-	if err != nil {
-		return nil, xerrors.Errorf("get etcd URL: %w", err)
-	}
+// SchedulingPolicyWebhook returns the configured scheduling-policy webhook,
+// or nil if none was configured. cmd/simulator/app.Run passes it to
+// schedulingpolicywebhook.NewFactory to register the webhook's Filter/Score
+// plugin before the in-tree plugins run.
+func (c *Config) SchedulingPolicyWebhook() *WebhookConfig {
+	return c.schedulingPolicyWebhook
+}
 
-	corsAllowedOriginList, err := getCorsAllowedOriginList()
-	if err != nil {
-		return nil, xerrors.Errorf("get frontend URL: %w", err)
-	}
+// InitialSchedulerCfg returns the initial KubeSchedulerConfiguration to
+// start the scheduler with, always expressed in v1beta2.
+func (c *Config) InitialSchedulerCfg() *v1beta2config.KubeSchedulerConfiguration {
+	return c.initialSchedulerCfg
+}
 
-	apiurl := getKubeAPIServerURL()
+// InitialSchedulerCfgAPIVersion returns the apiVersion the initial
+// KubeSchedulerConfiguration was written in on disk (e.g. "kubescheduler.config.k8s.io/v1"),
+// even though InitialSchedulerCfg always returns it converted to v1beta2.
+func (c *Config) InitialSchedulerCfgAPIVersion() string {
+	return c.initialSchedulerCfgAPIVersion
+}
 
-	externalimportenabled := getExternalImportEnabled()
+// NewConfig builds a Config from an already-decoded and validated
+// apiconfig.SimulatorConfiguration. Callers are expected to have produced cc
+// via LoadConfig (or, in tests, by constructing it in-memory directly), so
+// this function does no file or environment I/O of its own.
+func NewConfig(cc *apiconfig.SimulatorConfiguration) (*Config, error) {
 	externalKubeClientCfg := &rest.Config{}
-	if externalimportenabled {
-		externalKubeClientCfg, err = GetKubeClientConfig()
+	if cc.ExternalImportEnabled {
+		cfg, err := GetKubeClientConfig(cc)
 		if err != nil {
 			return nil, xerrors.Errorf("get kube clientconfig: %w", err)
 		}
+		externalKubeClientCfg = cfg
 	}
 
-	initialschedulerCfg, err := getSchedulerCfg()
+	initialSchedulerCfg, apiVersion, err := getSchedulerCfg(cc.KubeSchedulerConfigPath)
 	if err != nil {
 		return nil, xerrors.Errorf("get SchedulerCfg: %w", err)
 	}
+	if apiVersion != v1beta2config.SchemeGroupVersion.String() {
+		klog.Warningf("the KubeSchedulerConfiguration at %q is %s; it was down-converted to %s and may have lost fields only the newer version supports", cc.KubeSchedulerConfigPath, apiVersion, v1beta2config.SchemeGroupVersion.String())
+	}
 
-	externalSchedEnabled, err := getExternalSchedulerEnabled()
+	webhookCfg, err := loadWebhookConfig(cc.SchedulingPolicyWebhook)
 	if err != nil {
-		return nil, xerrors.Errorf("get externalSchedulerEnabled: %w", err)
+		return nil, xerrors.Errorf("load scheduling policy webhook config: %w", err)
 	}
 
 	return &Config{
-		Port:                     port,
-		KubeAPIServerURL:         apiurl,
-		EtcdURL:                  etcdurl,
-		CorsAllowedOriginList:    corsAllowedOriginList,
-		InitialSchedulerCfg:      initialschedulerCfg,
-		ExternalImportEnabled:    externalimportenabled,
-		ExternalKubeClientCfg:    externalKubeClientCfg,
-		ExternalSchedulerEnabled: externalSchedEnabled,
+		Port:                          int(cc.Port),
+		KubeAPIServerURL:              kubeAPIServerURL(cc),
+		EtcdURL:                       cc.EtcdURL,
+		CorsAllowedOriginList:         cc.CorsAllowedOriginList,
+		initialSchedulerCfg:           initialSchedulerCfg,
+		initialSchedulerCfgAPIVersion: apiVersion,
+		ExternalImportEnabled:         cc.ExternalImportEnabled,
+		ExternalKubeClientCfg:         externalKubeClientCfg,
+		ExternalSchedulerEnabled:      cc.ExternalSchedulerEnabled,
+		LeaderElection:                cc.LeaderElection,
+		HealthzBindAddress:            cc.HealthzBindAddress,
+		MetricsBindAddress:            cc.MetricsBindAddress,
+		schedulingPolicyWebhook:       webhookCfg,
 	}, nil
 }
 
-// ReadConfigYaml read the yaml file and set configYaml
-func readConfigYaml() {
-	var configByte []byte
-	var err error
-
-	configByte, err = ioutil.ReadFile(YamlFile)
-	if err != nil {
-		//level.Error(logger).Log("msg", "Error reading config file", "error", err)
-		return
-	}
-
-	if err = yaml.Unmarshal(configByte, configYaml); err != nil {
-		return
-	}
-}
-
-// getPort gets Port from the environment variable named PORT.
-func getPort() (int, error) {
-	port := configYaml.Port
-
-	return port, nil
-}
-
-func getKubeAPIServerURL() string {
-	port := configYaml.KubeApiPort
-
-	host := configYaml.KubeApiHost
-	if host == "" {
-		host = "127.0.0.1"
-	}
-	return host + ":" + strconv.Itoa(port)
-}
-
-func getExternalSchedulerEnabled() (bool, error) {
-	isExternalSchedulerEnabled := configYaml.ExternalSchedulerEnabled
-
-	return isExternalSchedulerEnabled, nil
-}
-
-func getEtcdURL() (string, error) {
-	etcdURL := configYaml.EtcdURL
-
-	return etcdURL, nil
-}
-
-// getCorsAllowedOriginList fetches CorsAllowedOriginList from the env named CORS_ALLOWED_ORIGIN_LIST.
-// This allowed list is applied to kube-apiserver and the simulator server.
-//
-// Let's say CORS_ALLOWED_ORIGIN_LIST="http://localhost:3000, http://localhost:3001, http://localhost:3002" are given.
-// Then, getCorsAllowedOriginList returns []string{"http://localhost:3000", "http://localhost:3001", "http://localhost:3002"}
-func getCorsAllowedOriginList() ([]string, error) {
-	corsAllowedOriginList := configYaml.CorsAllowedOriginList
-
-	if err := validateURLs(corsAllowedOriginList); err != nil {
-		return nil, xerrors.Errorf("validate origins in CORS_ALLOWED_ORIGIN_LIST: %w", err)
-	}
-
-	return corsAllowedOriginList, nil
+// kubeAPIServerURL joins the configured kube-apiserver host and port into
+// the single address the rest of the simulator expects.
+func kubeAPIServerURL(cc *apiconfig.SimulatorConfiguration) string {
+	return cc.KubeAPIHost + ":" + strconv.Itoa(int(cc.KubeAPIPort))
 }
 
-// validateURLs checks if all URLs in slice is valid or not.
-func validateURLs(urls []string) error {
-	for _, u := range urls {
-		_, err := url.ParseRequestURI(u)
-		if err != nil {
-			return xerrors.Errorf("parse request uri: %w", err)
-		}
-	}
-	return nil
-}
-
-// getSchedulerCfg reads KUBE_SCHEDULER_CONFIG_PATH which means initial kube-scheduler configuration
-// and converts it into *v1beta2config.KubeSchedulerConfiguration.
-// KUBE_SCHEDULER_CONFIG_PATH is not required.
-// If KUBE_SCHEDULER_CONFIG_PATH is not set, the default configuration of kube-scheduler will be used.
-func getSchedulerCfg() (*v1beta2config.KubeSchedulerConfiguration, error) {
-	kubeSchedulerConfigPath := configYaml.KubeSchedulerConfigPath
-	if kubeSchedulerConfigPath == "" {
+// getSchedulerCfg reads the KubeSchedulerConfiguration at path, accepting
+// any version kube-scheduler itself accepts, and returns it converted to
+// *v1beta2config.KubeSchedulerConfiguration along with the apiVersion it was
+// actually written in. path may be empty, in which case the default
+// configuration of kube-scheduler is used.
+func getSchedulerCfg(path string) (*v1beta2config.KubeSchedulerConfiguration, string, error) {
+	if path == "" {
 		dsc, err := config.DefaultSchedulerConfig()
 		if err != nil {
-			return nil, xerrors.Errorf("create default scheduler config: %w", err)
+			return nil, "", xerrors.Errorf("create default scheduler config: %w", err)
 		}
-		return dsc, nil
-	}
-
-	data, err := os.ReadFile(kubeSchedulerConfigPath)
-	if err != nil {
-		return nil, xerrors.Errorf("read scheduler config file: %w", err)
+		return dsc, v1beta2config.SchemeGroupVersion.String(), nil
 	}
 
-	sc, err := decodeSchedulerCfg(data)
+	data, err := os.ReadFile(path)
 	if err != nil {
-		return nil, xerrors.Errorf("decode scheduler config file: %w", err)
+		return nil, "", xerrors.Errorf("read scheduler config file: %w", err)
 	}
 
-	return sc, nil
-}
-
-// getExternalImportEnabled reads EXTERNAL_IMPORT_ENABLED and convert it to bool.
-// This function will return `true` if `EXTERNAL_IMPORT_ENABLED` is "1".
-func getExternalImportEnabled() bool {
-	isExternalImportEnabled := configYaml.ExternalImportEnabled
-	return isExternalImportEnabled == true
-}
-
-func decodeSchedulerCfg(buf []byte) (*v1beta2config.KubeSchedulerConfiguration, error) {
-	decoder := scheme.Codecs.UniversalDeserializer()
-	obj, _, err := decoder.Decode(buf, nil, nil)
+	sc, apiVersion, err := decodeSchedulerCfg(data)
 	if err != nil {
-		return nil, xerrors.Errorf("load an k8s object from buffer: %w", err)
+		return nil, "", xerrors.Errorf("decode scheduler config file: %w", err)
 	}
 
-	sc, ok := obj.(*v1beta2config.KubeSchedulerConfiguration)
-	if !ok {
-		return nil, xerrors.Errorf("convert to *v1beta2config.KubeSchedulerConfiguration, but got unexpected type: %T", obj)
-	}
-
-	if err = sc.DecodeNestedObjects(decoder); err != nil {
-		return nil, xerrors.Errorf("decode nested plugin args: %w", err)
-	}
-	return sc, nil
-}
-
-func GetKubeClientConfig() (*rest.Config, error) {
-	kubeConfig := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
-		clientcmd.NewDefaultClientConfigLoadingRules(), &clientcmd.ConfigOverrides{})
-	config, err := kubeConfig.ClientConfig()
-	if err != nil {
-		return nil, xerrors.Errorf("get client config: %w", err)
-	}
-	return config, nil
+	return sc, apiVersion, nil
 }
@@ -0,0 +1,51 @@
+package config
+
+import (
+	"golang.org/x/xerrors"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	apiconfig "sigs.k8s.io/kube-scheduler-simulator/simulator/config/apis/config"
+)
+
+// GetKubeClientConfig builds the *rest.Config used to talk to the external
+// cluster the simulator imports resources from, honoring cc's kubeconfig
+// path, context, master URL, TLS and impersonation overrides. When no
+// kubeconfig can be discovered (neither ExternalKubeConfigPath, $KUBECONFIG
+// nor ~/.kube/config), it falls back to rest.InClusterConfig() so the
+// simulator can run as a pod importing from its host cluster.
+func GetKubeClientConfig(cc *apiconfig.SimulatorConfiguration) (*rest.Config, error) {
+	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	if cc.ExternalKubeConfigPath != "" {
+		loadingRules.ExplicitPath = cc.ExternalKubeConfigPath
+	}
+
+	overrides := &clientcmd.ConfigOverrides{
+		ClusterInfo: clientcmdapi.Cluster{
+			Server:                cc.ExternalKubeMasterURL,
+			InsecureSkipTLSVerify: cc.ExternalKubeInsecureSkipTLSVerify,
+		},
+	}
+	if cc.ExternalKubeContext != "" {
+		overrides.CurrentContext = cc.ExternalKubeContext
+	}
+	if cc.ExternalKubeImpersonateUser != "" {
+		overrides.AuthInfo.Impersonate = cc.ExternalKubeImpersonateUser
+		overrides.AuthInfo.ImpersonateGroups = cc.ExternalKubeImpersonateGroups
+	}
+
+	kubeConfig := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, overrides)
+	cfg, err := kubeConfig.ClientConfig()
+	if err != nil {
+		if clientcmd.IsEmptyConfig(err) {
+			inClusterCfg, inClusterErr := rest.InClusterConfig()
+			if inClusterErr != nil {
+				return nil, xerrors.Errorf("no kubeconfig found and not running in-cluster: %w", inClusterErr)
+			}
+			return inClusterCfg, nil
+		}
+		return nil, xerrors.Errorf("get client config: %w", err)
+	}
+	return cfg, nil
+}
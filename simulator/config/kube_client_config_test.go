@@ -0,0 +1,112 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	apiconfig "sigs.k8s.io/kube-scheduler-simulator/simulator/config/apis/config"
+)
+
+const testKubeconfig = `
+apiVersion: v1
+kind: Config
+clusters:
+- name: test-cluster
+  cluster:
+    server: https://example.com:6443
+contexts:
+- name: test-context
+  context:
+    cluster: test-cluster
+    user: test-user
+current-context: test-context
+users:
+- name: test-user
+  user:
+    token: test-token
+`
+
+func writeTestKubeconfig(t *testing.T) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "kubeconfig")
+	if err := os.WriteFile(path, []byte(testKubeconfig), 0o600); err != nil {
+		t.Fatalf("write test kubeconfig: %v", err)
+	}
+	return path
+}
+
+func TestGetKubeClientConfigOverrides(t *testing.T) {
+	kubeconfigPath := writeTestKubeconfig(t)
+
+	t.Run("uses the kubeconfig's cluster server by default", func(t *testing.T) {
+		cc := &apiconfig.SimulatorConfiguration{ExternalKubeConfigPath: kubeconfigPath}
+
+		got, err := GetKubeClientConfig(cc)
+		if err != nil {
+			t.Fatalf("GetKubeClientConfig: %v", err)
+		}
+		if got.Host != "https://example.com:6443" {
+			t.Errorf("Host = %q, want %q", got.Host, "https://example.com:6443")
+		}
+	})
+
+	t.Run("ExternalKubeMasterURL overrides the kubeconfig's server", func(t *testing.T) {
+		cc := &apiconfig.SimulatorConfiguration{
+			ExternalKubeConfigPath: kubeconfigPath,
+			ExternalKubeMasterURL:  "https://override.example.com:6443",
+		}
+
+		got, err := GetKubeClientConfig(cc)
+		if err != nil {
+			t.Fatalf("GetKubeClientConfig: %v", err)
+		}
+		if got.Host != "https://override.example.com:6443" {
+			t.Errorf("Host = %q, want %q", got.Host, "https://override.example.com:6443")
+		}
+	})
+
+	t.Run("ExternalKubeInsecureSkipTLSVerify is applied", func(t *testing.T) {
+		cc := &apiconfig.SimulatorConfiguration{
+			ExternalKubeConfigPath:            kubeconfigPath,
+			ExternalKubeInsecureSkipTLSVerify: true,
+		}
+
+		got, err := GetKubeClientConfig(cc)
+		if err != nil {
+			t.Fatalf("GetKubeClientConfig: %v", err)
+		}
+		if !got.Insecure {
+			t.Errorf("Insecure = false, want true")
+		}
+	})
+
+	t.Run("ExternalKubeImpersonateUser/Groups are applied", func(t *testing.T) {
+		cc := &apiconfig.SimulatorConfiguration{
+			ExternalKubeConfigPath:        kubeconfigPath,
+			ExternalKubeImpersonateUser:   "test-impersonated-user",
+			ExternalKubeImpersonateGroups: []string{"group-a", "group-b"},
+		}
+
+		got, err := GetKubeClientConfig(cc)
+		if err != nil {
+			t.Fatalf("GetKubeClientConfig: %v", err)
+		}
+		if got.Impersonate.UserName != "test-impersonated-user" {
+			t.Errorf("Impersonate.UserName = %q, want %q", got.Impersonate.UserName, "test-impersonated-user")
+		}
+		if len(got.Impersonate.Groups) != 2 || got.Impersonate.Groups[0] != "group-a" || got.Impersonate.Groups[1] != "group-b" {
+			t.Errorf("Impersonate.Groups = %v, want [group-a group-b]", got.Impersonate.Groups)
+		}
+	})
+
+	t.Run("no kubeconfig and not running in-cluster returns an error", func(t *testing.T) {
+		t.Setenv("KUBECONFIG", "")
+		t.Setenv("HOME", t.TempDir())
+
+		if _, err := GetKubeClientConfig(&apiconfig.SimulatorConfiguration{}); err == nil {
+			t.Fatal("GetKubeClientConfig: want error, got nil")
+		}
+	})
+}
@@ -0,0 +1,60 @@
+package config
+
+import (
+	"context"
+	"os"
+
+	"golang.org/x/xerrors"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+	"k8s.io/klog/v2"
+)
+
+// RunLeaderElection runs run under leader election using c's LeaderElection
+// configuration, or runs it directly if leader election is disabled. It
+// blocks until ctx is cancelled or leadership is lost.
+func (c *Config) RunLeaderElection(ctx context.Context, run func(ctx context.Context)) error {
+	if !c.LeaderElection.LeaderElect {
+		run(ctx)
+		return nil
+	}
+
+	restCfg, err := rest.InClusterConfig()
+	if err != nil {
+		return xerrors.Errorf("get in-cluster config for leader election: %w", err)
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		return xerrors.Errorf("get hostname: %w", err)
+	}
+	id := hostname + "_" + string(uuid.NewUUID())
+
+	rl, err := resourcelock.NewFromKubeconfig(
+		c.LeaderElection.ResourceLock,
+		c.LeaderElection.ResourceNamespace,
+		c.LeaderElection.ResourceName,
+		resourcelock.ResourceLockConfig{Identity: id},
+		restCfg,
+		c.LeaderElection.RenewDeadline.Duration,
+	)
+	if err != nil {
+		return xerrors.Errorf("create leader election resource lock: %w", err)
+	}
+
+	leaderelection.RunOrDie(ctx, leaderelection.LeaderElectionConfig{
+		Lock:          rl,
+		LeaseDuration: c.LeaderElection.LeaseDuration.Duration,
+		RenewDeadline: c.LeaderElection.RenewDeadline.Duration,
+		RetryPeriod:   c.LeaderElection.RetryPeriod.Duration,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: run,
+			OnStoppedLeading: func() {
+				klog.Info("leaderelection lost, simulator is stopping")
+			},
+		},
+	})
+	return nil
+}
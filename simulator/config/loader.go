@@ -0,0 +1,44 @@
+package config
+
+import (
+	"os"
+
+	"golang.org/x/xerrors"
+
+	apiconfig "sigs.k8s.io/kube-scheduler-simulator/simulator/config/apis/config"
+	"sigs.k8s.io/kube-scheduler-simulator/simulator/config/apis/config/scheme"
+	"sigs.k8s.io/kube-scheduler-simulator/simulator/config/apis/config/v1alpha1"
+	"sigs.k8s.io/kube-scheduler-simulator/simulator/config/apis/config/validation"
+)
+
+// LoadConfig reads the simulator configuration file at path, decodes it as
+// v1alpha1.SimulatorConfiguration, applies defaults, converts it to the
+// internal apiconfig.SimulatorConfiguration, and validates it. path may be
+// empty, in which case a default, empty v1alpha1.SimulatorConfiguration is
+// used (so that all fields end up at their defaults).
+func LoadConfig(path string) (*apiconfig.SimulatorConfiguration, error) {
+	versioned := &v1alpha1.SimulatorConfiguration{}
+
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, xerrors.Errorf("read config file: %w", err)
+		}
+		if _, _, err := scheme.Codecs.UniversalDecoder().Decode(data, nil, versioned); err != nil {
+			return nil, xerrors.Errorf("decode config file: %w", err)
+		}
+	}
+
+	scheme.Scheme.Default(versioned)
+
+	internal := &apiconfig.SimulatorConfiguration{}
+	if err := scheme.Scheme.Convert(versioned, internal, nil); err != nil {
+		return nil, xerrors.Errorf("convert to internal SimulatorConfiguration: %w", err)
+	}
+
+	if err := validation.ValidateSimulatorConfiguration(internal); err != nil {
+		return nil, xerrors.Errorf("validate simulator configuration: %w", err)
+	}
+
+	return internal, nil
+}
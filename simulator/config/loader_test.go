@@ -0,0 +1,23 @@
+package config
+
+import "testing"
+
+func TestLoadConfigDefaultsWithNoPath(t *testing.T) {
+	cc, err := LoadConfig("")
+	if err != nil {
+		t.Fatalf("LoadConfig(\"\"): %v", err)
+	}
+
+	if cc.Port <= 0 {
+		t.Errorf("Port = %d, want a positive default", cc.Port)
+	}
+	if cc.KubeAPIPort <= 0 {
+		t.Errorf("KubeAPIPort = %d, want a positive default", cc.KubeAPIPort)
+	}
+	if cc.KubeAPIHost == "" {
+		t.Errorf("KubeAPIHost is empty, want a default host")
+	}
+	if cc.EtcdURL == "" {
+		t.Errorf("EtcdURL is empty, want a default URL")
+	}
+}
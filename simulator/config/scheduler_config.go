@@ -0,0 +1,49 @@
+package config
+
+import (
+	"golang.org/x/xerrors"
+	"k8s.io/apimachinery/pkg/runtime"
+	v1beta2config "k8s.io/kube-scheduler/config/v1beta2"
+	kubeschedulerconfig "k8s.io/kubernetes/pkg/scheduler/apis/config"
+	"k8s.io/kubernetes/pkg/scheduler/apis/config/scheme"
+)
+
+// nestedObjectDecoder is implemented by every external
+// KubeSchedulerConfiguration version (v1beta2, v1beta3, v1, ...); it decodes
+// the raw PluginConfig.Args of each plugin into its typed args object.
+type nestedObjectDecoder interface {
+	DecodeNestedObjects(d runtime.Decoder) error
+}
+
+// decodeSchedulerCfg decodes buf, the raw contents of a
+// KubeSchedulerConfiguration file of any version the cluster's
+// kube-scheduler understands, and converts it into the single
+// *v1beta2config.KubeSchedulerConfiguration the rest of the simulator
+// expects. It also returns the apiVersion the file was actually written in,
+// so callers can warn when a newer version (e.g. v1) was down-converted and
+// may have lost fields v1beta2 doesn't have.
+func decodeSchedulerCfg(buf []byte) (*v1beta2config.KubeSchedulerConfiguration, string, error) {
+	decoder := scheme.Codecs.UniversalDeserializer()
+	obj, gvk, err := decoder.Decode(buf, nil, nil)
+	if err != nil {
+		return nil, "", xerrors.Errorf("load an k8s object from buffer: %w", err)
+	}
+
+	if nd, ok := obj.(nestedObjectDecoder); ok {
+		if err := nd.DecodeNestedObjects(decoder); err != nil {
+			return nil, "", xerrors.Errorf("decode nested plugin args: %w", err)
+		}
+	}
+
+	internal := &kubeschedulerconfig.KubeSchedulerConfiguration{}
+	if err := scheme.Scheme.Convert(obj, internal, nil); err != nil {
+		return nil, "", xerrors.Errorf("convert %T to internal KubeSchedulerConfiguration: %w", obj, err)
+	}
+
+	out := &v1beta2config.KubeSchedulerConfiguration{}
+	if err := scheme.Scheme.Convert(internal, out, nil); err != nil {
+		return nil, "", xerrors.Errorf("convert internal KubeSchedulerConfiguration to v1beta2: %w", err)
+	}
+
+	return out, gvk.GroupVersion().String(), nil
+}
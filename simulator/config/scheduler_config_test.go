@@ -0,0 +1,116 @@
+package config
+
+import (
+	"testing"
+
+	v1beta2config "k8s.io/kube-scheduler/config/v1beta2"
+)
+
+const v1beta2CfgYaml = `
+apiVersion: kubescheduler.config.k8s.io/v1beta2
+kind: KubeSchedulerConfiguration
+profiles:
+- schedulerName: default-scheduler
+  plugins:
+    multiPoint:
+      enabled:
+      - name: NodeResourcesFit
+  pluginConfig:
+  - name: NodeResourcesFit
+    args:
+      apiVersion: kubescheduler.config.k8s.io/v1beta2
+      kind: NodeResourcesFitArgs
+      scoringStrategy:
+        type: LeastAllocated
+`
+
+const v1beta3CfgYaml = `
+apiVersion: kubescheduler.config.k8s.io/v1beta3
+kind: KubeSchedulerConfiguration
+profiles:
+- schedulerName: default-scheduler
+  plugins:
+    multiPoint:
+      enabled:
+      - name: NodeResourcesFit
+  pluginConfig:
+  - name: NodeResourcesFit
+    args:
+      apiVersion: kubescheduler.config.k8s.io/v1beta3
+      kind: NodeResourcesFitArgs
+      scoringStrategy:
+        type: LeastAllocated
+`
+
+const v1CfgYaml = `
+apiVersion: kubescheduler.config.k8s.io/v1
+kind: KubeSchedulerConfiguration
+profiles:
+- schedulerName: default-scheduler
+  plugins:
+    multiPoint:
+      enabled:
+      - name: NodeResourcesFit
+  pluginConfig:
+  - name: NodeResourcesFit
+    args:
+      apiVersion: kubescheduler.config.k8s.io/v1
+      kind: NodeResourcesFitArgs
+      scoringStrategy:
+        type: LeastAllocated
+`
+
+func TestDecodeSchedulerCfg(t *testing.T) {
+	tests := map[string]struct {
+		yaml              string
+		wantAPIVersion    string
+		wantSchedulerName string
+	}{
+		"v1beta2": {
+			yaml:              v1beta2CfgYaml,
+			wantAPIVersion:    "kubescheduler.config.k8s.io/v1beta2",
+			wantSchedulerName: "default-scheduler",
+		},
+		"v1beta3": {
+			yaml:              v1beta3CfgYaml,
+			wantAPIVersion:    "kubescheduler.config.k8s.io/v1beta3",
+			wantSchedulerName: "default-scheduler",
+		},
+		"v1": {
+			yaml:              v1CfgYaml,
+			wantAPIVersion:    "kubescheduler.config.k8s.io/v1",
+			wantSchedulerName: "default-scheduler",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, apiVersion, err := decodeSchedulerCfg([]byte(tt.yaml))
+			if err != nil {
+				t.Fatalf("decodeSchedulerCfg: %v", err)
+			}
+			if apiVersion != tt.wantAPIVersion {
+				t.Errorf("apiVersion = %q, want %q", apiVersion, tt.wantAPIVersion)
+			}
+			if len(got.Profiles) != 1 || got.Profiles[0].SchedulerName == nil || *got.Profiles[0].SchedulerName != tt.wantSchedulerName {
+				t.Fatalf("unexpected profiles: %+v", got.Profiles)
+			}
+
+			plugins := got.Profiles[0].Plugins
+			if plugins == nil || len(plugins.MultiPoint.Enabled) != 1 || plugins.MultiPoint.Enabled[0].Name != "NodeResourcesFit" {
+				t.Fatalf("unexpected multiPoint plugins: %+v", plugins)
+			}
+
+			if len(got.Profiles[0].PluginConfig) != 1 {
+				t.Fatalf("unexpected pluginConfig: %+v", got.Profiles[0].PluginConfig)
+			}
+			args, ok := got.Profiles[0].PluginConfig[0].Args.Object.(*v1beta2config.NodeResourcesFitArgs)
+			if !ok {
+				t.Fatalf("PluginConfig[0].Args.Object = %T, want *v1beta2config.NodeResourcesFitArgs", got.Profiles[0].PluginConfig[0].Args.Object)
+			}
+			if args.ScoringStrategy == nil || args.ScoringStrategy.Type != v1beta2config.LeastAllocated {
+				t.Fatalf("unexpected NodeResourcesFitArgs: %+v", args)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package config
+
+import (
+	"net/url"
+	"time"
+
+	"golang.org/x/xerrors"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+
+	apiconfig "sigs.k8s.io/kube-scheduler-simulator/simulator/config/apis/config"
+)
+
+// DefaultSchedulingPolicyWebhookTimeout is used when the webhook's
+// kubeconfig doesn't set a client timeout.
+const DefaultSchedulingPolicyWebhookTimeout = 5 * time.Second
+
+// WebhookConfig is the ready-to-use configuration for the optional
+// scheduling-policy webhook: a REST client pointed at the webhook server
+// plus the failure and retry semantics the scheduler wiring applies around
+// it when registering the webhook's Filter/Score plugin.
+type WebhookConfig struct {
+	// RestConfig talks to the webhook server.
+	RestConfig *rest.Config
+	// FailurePolicy is apiconfig.FailurePolicyIgnore or
+	// apiconfig.FailurePolicyFail.
+	FailurePolicy string
+	// RetryBackoff is how long to wait between retries of a failed webhook
+	// call.
+	RetryBackoff time.Duration
+}
+
+// loadWebhookConfig builds a *WebhookConfig from wc, loading the webhook's
+// kubeconfig the same way kube-apiserver loads its admission and
+// authorization webhook kubeconfigs. It returns nil, nil when wc is nil, so
+// the webhook is simply absent from Config when it isn't configured.
+func loadWebhookConfig(wc *apiconfig.SchedulingPolicyWebhookConfiguration) (*WebhookConfig, error) {
+	if wc == nil {
+		return nil, nil
+	}
+
+	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	loadingRules.ExplicitPath = wc.KubeconfigPath
+
+	restCfg, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, &clientcmd.ConfigOverrides{}).ClientConfig()
+	if err != nil {
+		return nil, xerrors.Errorf("get scheduling policy webhook clientconfig: %w", err)
+	}
+	if _, err := url.ParseRequestURI(restCfg.Host); err != nil {
+		return nil, xerrors.Errorf("scheduling policy webhook kubeconfig has no valid server URL: %w", err)
+	}
+	if restCfg.Timeout < 0 {
+		return nil, xerrors.Errorf("scheduling policy webhook kubeconfig timeout must not be negative: %s", restCfg.Timeout)
+	}
+	if restCfg.Timeout == 0 {
+		restCfg.Timeout = DefaultSchedulingPolicyWebhookTimeout
+	}
+
+	return &WebhookConfig{
+		RestConfig:    restCfg,
+		FailurePolicy: wc.FailurePolicy,
+		RetryBackoff:  wc.RetryBackoff.Duration,
+	}, nil
+}
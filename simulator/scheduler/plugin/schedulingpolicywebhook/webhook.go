@@ -0,0 +1,205 @@
+// Package schedulingpolicywebhook implements the optional scheduling-policy
+// webhook: a Filter/Score plugin that POSTs each pod's scheduling context to
+// an external webhook and applies the node constraints or scoring weight it
+// returns, before the in-tree plugins run.
+package schedulingpolicywebhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+	frameworkruntime "k8s.io/kubernetes/pkg/scheduler/framework/runtime"
+
+	simulatorconfig "sigs.k8s.io/kube-scheduler-simulator/simulator/config"
+	apiconfig "sigs.k8s.io/kube-scheduler-simulator/simulator/config/apis/config"
+)
+
+// Name is the name the plugin is registered under.
+const Name = "SchedulingPolicyWebhook"
+
+// maxAttempts is the number of times a webhook call is attempted before
+// FailurePolicy is applied: the initial attempt plus two retries, waiting
+// RetryBackoff between each.
+const maxAttempts = 3
+
+var (
+	_ framework.FilterPlugin = &Plugin{}
+	_ framework.ScorePlugin  = &Plugin{}
+)
+
+// Plugin is the SchedulingPolicyWebhook Filter/Score plugin. It is a no-op
+// (every node passes Filter, every Score is 0) when no webhook is
+// configured, so it can always be registered regardless of configuration.
+type Plugin struct {
+	cfg    *simulatorconfig.WebhookConfig
+	client *http.Client
+}
+
+// New builds the SchedulingPolicyWebhook plugin from cfg, which is normally
+// simulator/config's Config.SchedulingPolicyWebhook(). cfg may be nil.
+func New(cfg *simulatorconfig.WebhookConfig) (framework.Plugin, error) {
+	if cfg == nil {
+		return &Plugin{}, nil
+	}
+
+	client, err := rest.HTTPClientFor(cfg.RestConfig)
+	if err != nil {
+		return nil, fmt.Errorf("build scheduling policy webhook http client: %w", err)
+	}
+
+	return &Plugin{cfg: cfg, client: client}, nil
+}
+
+// NewFactory adapts New into the scheduler framework's frameworkruntime.PluginFactory
+// shape (func(runtime.Object, framework.Handle) (framework.Plugin, error)), so
+// it can be registered under Name in a frameworkruntime.Registry. The
+// runtime.Object a PluginFactory normally receives is the profile's
+// PluginConfig.Args, which this plugin has no use for: its settings come
+// from cfg, the simulator's own SimulatorConfiguration, not from a
+// per-profile config block.
+func NewFactory(cfg *simulatorconfig.WebhookConfig) frameworkruntime.PluginFactory {
+	return func(_ runtime.Object, _ framework.Handle) (framework.Plugin, error) {
+		return New(cfg)
+	}
+}
+
+// Name implements framework.Plugin.
+func (p *Plugin) Name() string {
+	return Name
+}
+
+// Filter implements framework.FilterPlugin. It POSTs the pod and the
+// candidate node to the webhook and rejects the node when the webhook says
+// it isn't allowed, or when the webhook can't be reached and FailurePolicy
+// is FailurePolicyFail.
+func (p *Plugin) Filter(ctx context.Context, _ *framework.CycleState, pod *v1.Pod, nodeInfo *framework.NodeInfo) *framework.Status {
+	if p.cfg == nil {
+		return nil
+	}
+
+	resp, err := p.call(ctx, pod, nodeInfo.Node())
+	if err != nil {
+		return p.statusForError(err)
+	}
+	if !resp.Allowed {
+		return framework.NewStatus(framework.Unschedulable, resp.Reason)
+	}
+	return nil
+}
+
+// Score implements framework.ScorePlugin. It POSTs the pod and the named
+// node to the webhook and returns the score it assigns the node.
+func (p *Plugin) Score(ctx context.Context, _ *framework.CycleState, pod *v1.Pod, nodeName string) (int64, *framework.Status) {
+	if p.cfg == nil {
+		return 0, nil
+	}
+
+	resp, err := p.call(ctx, pod, &v1.Node{ObjectMeta: metav1.ObjectMeta{Name: nodeName}})
+	if err != nil {
+		return 0, p.statusForError(err)
+	}
+	return resp.Score, nil
+}
+
+// ScoreExtensions implements framework.ScorePlugin. The webhook's score
+// needs no further normalization.
+func (p *Plugin) ScoreExtensions() framework.ScoreExtensions {
+	return nil
+}
+
+// webhookRequest is the scheduling context POSTed to the webhook for a
+// single (pod, node) pair.
+type webhookRequest struct {
+	Pod  *v1.Pod  `json:"pod"`
+	Node *v1.Node `json:"node"`
+}
+
+// webhookResponse is the decision the webhook returns for a (pod, node)
+// pair: whether the node is allowed to run the pod, and the score it
+// should receive if so.
+type webhookResponse struct {
+	// Allowed, when false, fails Filter for this node; Reason is surfaced
+	// as the pod's scheduling failure message.
+	Allowed bool   `json:"allowed"`
+	Reason  string `json:"reason,omitempty"`
+	// Score is returned as-is from Score.
+	Score int64 `json:"score,omitempty"`
+}
+
+// statusForError applies FailurePolicy to a webhook call that ultimately
+// failed after every retry: FailurePolicyFail marks the pod Unschedulable,
+// FailurePolicyIgnore lets it proceed as if the webhook weren't configured.
+func (p *Plugin) statusForError(err error) *framework.Status {
+	if p.cfg.FailurePolicy == apiconfig.FailurePolicyFail {
+		return framework.NewStatus(framework.Unschedulable, fmt.Sprintf("scheduling policy webhook: %v", err))
+	}
+	klog.Warningf("scheduling policy webhook unreachable, ignoring per failurePolicy=%s: %v", apiconfig.FailurePolicyIgnore, err)
+	return nil
+}
+
+// call POSTs pod and node to the webhook, making at most maxAttempts
+// attempts in all with cfg.RetryBackoff between attempts.
+func (p *Plugin) call(ctx context.Context, pod *v1.Pod, node *v1.Node) (*webhookResponse, error) {
+	body, err := json.Marshal(webhookRequest{Pod: pod, Node: node})
+	if err != nil {
+		return nil, fmt.Errorf("marshal scheduling policy webhook request: %w", err)
+	}
+
+	var lastErr error
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(p.cfg.RetryBackoff):
+			}
+		}
+
+		resp, err := p.post(ctx, body)
+		if err == nil {
+			return resp, nil
+		}
+		lastErr = err
+	}
+	return nil, lastErr
+}
+
+// post makes a single POST attempt against the webhook.
+func (p *Plugin) post(ctx context.Context, body []byte) (*webhookResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.cfg.RestConfig.Host, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("build scheduling policy webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	httpResp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("call scheduling policy webhook: %w", err)
+	}
+	defer httpResp.Body.Close()
+
+	respBody, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read scheduling policy webhook response: %w", err)
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("scheduling policy webhook returned status %d: %s", httpResp.StatusCode, respBody)
+	}
+
+	resp := &webhookResponse{}
+	if err := json.Unmarshal(respBody, resp); err != nil {
+		return nil, fmt.Errorf("decode scheduling policy webhook response: %w", err)
+	}
+	return resp, nil
+}
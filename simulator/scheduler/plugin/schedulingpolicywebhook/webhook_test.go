@@ -0,0 +1,119 @@
+package schedulingpolicywebhook
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/kubernetes/pkg/scheduler/framework"
+
+	simulatorconfig "sigs.k8s.io/kube-scheduler-simulator/simulator/config"
+	apiconfig "sigs.k8s.io/kube-scheduler-simulator/simulator/config/apis/config"
+)
+
+func newTestPlugin(t *testing.T, handler http.HandlerFunc, failurePolicy string) *Plugin {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	p, err := New(&simulatorconfig.WebhookConfig{
+		RestConfig:    &rest.Config{Host: server.URL},
+		FailurePolicy: failurePolicy,
+		RetryBackoff:  time.Millisecond,
+	})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	return p.(*Plugin)
+}
+
+func TestFilterRejectsWhenWebhookDisallows(t *testing.T) {
+	p := newTestPlugin(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"allowed": false, "reason": "node is cordoned by policy"}`))
+	}, apiconfig.FailurePolicyFail)
+
+	pod := &v1.Pod{}
+	nodeInfo := framework.NewNodeInfo()
+	nodeInfo.SetNode(&v1.Node{})
+
+	status := p.Filter(context.Background(), nil, pod, nodeInfo)
+	if status.IsSuccess() {
+		t.Fatalf("Filter status = success, want Unschedulable")
+	}
+	if status.Code() != framework.Unschedulable {
+		t.Errorf("Filter status code = %v, want Unschedulable", status.Code())
+	}
+}
+
+func TestFilterAllowsWhenWebhookAllows(t *testing.T) {
+	p := newTestPlugin(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"allowed": true}`))
+	}, apiconfig.FailurePolicyFail)
+
+	pod := &v1.Pod{}
+	nodeInfo := framework.NewNodeInfo()
+	nodeInfo.SetNode(&v1.Node{})
+
+	if status := p.Filter(context.Background(), nil, pod, nodeInfo); !status.IsSuccess() {
+		t.Fatalf("Filter status = %v, want success", status)
+	}
+}
+
+func TestScoreReturnsWebhookScore(t *testing.T) {
+	p := newTestPlugin(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"allowed": true, "score": 42}`))
+	}, apiconfig.FailurePolicyFail)
+
+	score, status := p.Score(context.Background(), nil, &v1.Pod{}, "node-1")
+	if !status.IsSuccess() {
+		t.Fatalf("Score status = %v, want success", status)
+	}
+	if score != 42 {
+		t.Errorf("Score = %d, want 42", score)
+	}
+}
+
+func TestFilterFailurePolicy(t *testing.T) {
+	tests := map[string]struct {
+		failurePolicy string
+		wantSuccess   bool
+	}{
+		"Fail marks the pod Unschedulable": {failurePolicy: apiconfig.FailurePolicyFail, wantSuccess: false},
+		"Ignore lets the pod proceed":      {failurePolicy: apiconfig.FailurePolicyIgnore, wantSuccess: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			p := newTestPlugin(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusInternalServerError)
+			}, tt.failurePolicy)
+
+			nodeInfo := framework.NewNodeInfo()
+			nodeInfo.SetNode(&v1.Node{})
+
+			status := p.Filter(context.Background(), nil, &v1.Pod{}, nodeInfo)
+			if status.IsSuccess() != tt.wantSuccess {
+				t.Errorf("Filter status = %v, want success=%v", status, tt.wantSuccess)
+			}
+		})
+	}
+}
+
+func TestNewWithNilConfigIsNoOp(t *testing.T) {
+	plugin, err := New(nil)
+	if err != nil {
+		t.Fatalf("New(nil): %v", err)
+	}
+
+	nodeInfo := framework.NewNodeInfo()
+	nodeInfo.SetNode(&v1.Node{})
+
+	if status := plugin.(*Plugin).Filter(context.Background(), nil, &v1.Pod{}, nodeInfo); !status.IsSuccess() {
+		t.Errorf("Filter with no webhook configured = %v, want success", status)
+	}
+}
@@ -0,0 +1,45 @@
+// Package healthz serves /healthz, /readyz and Prometheus metrics on a bind
+// address independent of the simulator's main HTTP port, so liveness and
+// readiness probes don't compete with user traffic.
+package healthz
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"golang.org/x/xerrors"
+)
+
+// Serve starts an HTTP server on bindAddress exposing /healthz, /readyz and
+// /metrics, and blocks until ctx is cancelled, returning the shutdown error
+// (if any).
+func Serve(ctx context.Context, bindAddress string) error {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", handleOK)
+	mux.HandleFunc("/readyz", handleOK)
+	mux.Handle("/metrics", promhttp.Handler())
+
+	srv := &http.Server{Addr: bindAddress, Handler: mux}
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- srv.ListenAndServe()
+	}()
+
+	select {
+	case <-ctx.Done():
+		return srv.Shutdown(context.Background())
+	case err := <-errCh:
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			return xerrors.Errorf("serve healthz: %w", err)
+		}
+		return nil
+	}
+}
+
+func handleOK(w http.ResponseWriter, _ *http.Request) {
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte("ok"))
+}